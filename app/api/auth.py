@@ -0,0 +1,64 @@
+"""
+API Authentication - Static API keys for protected routes.
+
+Keys are configured as a comma-separated list in API_KEYS and sent either
+as an X-API-Key header or as an Authorization: Bearer token. Health and
+root routes stay public.
+"""
+
+import hmac
+import logging
+import os
+from typing import List, Optional
+
+from fastapi import Header, HTTPException
+
+logger = logging.getLogger(__name__)
+
+API_KEY_HEADER = "X-API-Key"
+
+
+def load_api_keys() -> List[str]:
+    """Read the configured API keys from API_KEYS."""
+    return [key.strip() for key in os.getenv("API_KEYS", "").split(",") if key.strip()]
+
+
+def _extract_key(x_api_key: Optional[str], authorization: Optional[str]) -> Optional[str]:
+    """Return the key from X-API-Key or a Bearer Authorization header."""
+    if x_api_key:
+        return x_api_key
+    if authorization:
+        scheme, _, token = authorization.partition(" ")
+        if scheme.lower() == "bearer" and token.strip():
+            return token.strip()
+    return None
+
+
+def require_api_key(
+    x_api_key: Optional[str] = Header(None, alias=API_KEY_HEADER),
+    authorization: Optional[str] = Header(None),
+):
+    """FastAPI dependency rejecting requests without a valid API key."""
+    keys = load_api_keys()
+    if not keys:
+        logger.warning("Rejected request: no API_KEYS configured")
+        raise HTTPException(status_code=401, detail="API key authentication is not configured")
+
+    provided = _extract_key(x_api_key, authorization)
+    if provided is None:
+        raise HTTPException(
+            status_code=401,
+            detail="Missing API key",
+            headers={"WWW-Authenticate": "Bearer"},
+        )
+
+    # Compare against every key so timing does not reveal which one matched
+    matched = False
+    for key in keys:
+        matched |= hmac.compare_digest(provided.encode(), key.encode())
+    if not matched:
+        raise HTTPException(
+            status_code=401,
+            detail="Invalid API key",
+            headers={"WWW-Authenticate": "Bearer"},
+        )
@@ -0,0 +1,83 @@
+"""
+Health Checks - Liveness and readiness probes for the API.
+
+Liveness only reports that the process is serving requests. Readiness
+actively checks the services and storage the API depends on. The LLM
+service is not checked because no API path calls it yet.
+"""
+
+import os
+import shutil
+import tempfile
+from pathlib import Path
+from typing import Any, Dict
+
+import requests
+
+from core.config import DEFAULT_DATA_DIR
+
+# Build-time version, injected via the VERSION build arg in Dockerfile.api
+VERSION = os.getenv("STACKGUIDE_VERSION", "0.1.0")
+
+DEFAULT_MIN_FREE_DISK_MB = 500
+
+# Keep the whole probe under the default 1s Kubernetes probe timeout
+CHECK_TIMEOUT_SECONDS = 0.8
+
+
+def _check_http(url: str) -> Dict[str, Any]:
+    """Check that an HTTP dependency answers with a 2xx status."""
+    try:
+        response = requests.get(url, timeout=CHECK_TIMEOUT_SECONDS)
+        if response.ok:
+            return {"status": "ok"}
+        return {"status": "error", "error": f"HTTP {response.status_code} from {url}"}
+    except requests.RequestException as e:
+        return {"status": "error", "error": str(e)}
+
+
+def check_chroma() -> Dict[str, Any]:
+    """Check that Chroma DB is reachable."""
+    host = os.getenv("CHROMA_HOST", "chroma")
+    port = os.getenv("CHROMA_PORT", "8000")
+    return _check_http(f"http://{host}:{port}/api/v2/heartbeat")
+
+
+def check_storage() -> Dict[str, Any]:
+    """Check that the data directory is writable and has enough free space."""
+    data_dir = Path(os.getenv("DATA_DIR", DEFAULT_DATA_DIR))
+    min_free_value = os.getenv("MIN_FREE_DISK_MB", "")
+    min_free_mb = int(min_free_value) if min_free_value.isdigit() else DEFAULT_MIN_FREE_DISK_MB
+
+    try:
+        with tempfile.NamedTemporaryFile(dir=data_dir):
+            pass
+        free_mb = shutil.disk_usage(data_dir).free // (1024 * 1024)
+    except OSError as e:
+        return {"status": "error", "error": f"Data directory {data_dir} is not writable: {e}"}
+
+    result = {"status": "ok", "free_mb": free_mb}
+    if free_mb < min_free_mb:
+        result["status"] = "error"
+        result["error"] = f"Only {free_mb} MB free, below the {min_free_mb} MB threshold"
+    return result
+
+
+def readiness() -> Dict[str, Any]:
+    """
+    Run all dependency checks.
+
+    Returns:
+        Dictionary with overall status and per-dependency results
+    """
+    checks = {
+        "chroma": check_chroma(),
+        "storage": check_storage(),
+    }
+    healthy = all(check["status"] == "ok" for check in checks.values())
+
+    return {
+        "status": "ready" if healthy else "degraded",
+        "version": VERSION,
+        "checks": checks,
+    }
@@ -2,40 +2,145 @@
 StackGuide FastAPI Backend
 """
 
-from fastapi import FastAPI
+import logging
+import os
+import threading
+from contextlib import asynccontextmanager
+
+from fastapi import Depends, FastAPI, HTTPException, Query
+from fastapi.responses import JSONResponse, Response
 from fastapi.middleware.cors import CORSMiddleware
+from prometheus_client import CONTENT_TYPE_LATEST, generate_latest
+from pydantic import BaseModel, Field
+
+from core.config import validate_startup, load_cors_config
+from core.config.cors import middleware_options
+from core.knowledge import KnowledgeEngine, QueryResponse
+from utils.logging import setup_logging
+from api.auth import require_api_key
+from api.middleware import MetricsMiddleware, RequestLoggingMiddleware
+from api.health import VERSION, readiness
+
+setup_logging(
+    level=os.getenv("LOG_LEVEL", "INFO"),
+    log_format=os.getenv("LOG_FORMAT", "text")
+)
+
+logger = logging.getLogger(__name__)
+
+@asynccontextmanager
+async def lifespan(app: FastAPI):
+    """Fail fast if the runtime configuration is invalid."""
+    validate_startup()
+    yield
 
 app = FastAPI(
     title="StackGuide API",
     description="Local-first AI Knowledge Assistant",
-    version="0.1.0"
+    version=VERSION,
+    lifespan=lifespan
 )
 
-# Add CORS middleware
-app.add_middleware(
-    CORSMiddleware,
-    allow_origins=["*"],
-    allow_credentials=True,
-    allow_methods=["*"],
-    allow_headers=["*"],
-)
+# Add CORS middleware (policy comes from CORS_* environment variables)
+app.add_middleware(CORSMiddleware, **middleware_options(load_cors_config()))
+
+# Add request ID and access logging middleware
+app.add_middleware(RequestLoggingMiddleware)
+
+# Add per-route Prometheus metrics middleware
+app.add_middleware(MetricsMiddleware)
 
 @app.get("/")
 async def root():
     """Root endpoint."""
     return {"message": "StackGuide API is running!"}
 
+@app.get("/healthz")
 @app.get("/health")
-async def health():
-    """Health check endpoint."""
-    return {"status": "healthy", "service": "StackGuide API"}
+async def liveness():
+    """Liveness probe: the process is up and serving requests."""
+    return {"status": "alive", "service": "StackGuide API", "version": VERSION}
+
+@app.get("/readyz")
+def ready():
+    """Readiness probe: checks Chroma and data storage."""
+    result = readiness()
+    status_code = 200 if result["status"] == "ready" else 503
+    return JSONResponse(result, status_code=status_code)
+
+@app.get("/metrics")
+def metrics():
+    """Prometheus metrics endpoint."""
+    return Response(generate_latest(), media_type=CONTENT_TYPE_LATEST)
 
-@app.get("/api/query")
-async def query(q: str):
-    """Query endpoint (placeholder)."""
+class AskRequest(BaseModel):
+    """Request body for the ask endpoint."""
+    question: str = Field(..., min_length=1)
+    max_results: int = Field(5, ge=1, le=20)
+
+KNOWLEDGE_BASE_UNAVAILABLE = "Knowledge base unavailable"
+
+_engine = None
+_engine_lock = threading.Lock()
+
+def get_engine() -> KnowledgeEngine:
+    """Create the knowledge engine on first use so startup doesn't need Chroma."""
+    global _engine
+    if _engine is not None:
+        return _engine
+
+    # Connect outside the lock so a hanging Chroma doesn't block every worker thread
+    try:
+        engine = KnowledgeEngine(
+            chroma_host=os.getenv("CHROMA_HOST", "chroma"),
+            chroma_port=int(os.getenv("CHROMA_PORT", "8000"))
+        )
+    except Exception as e:
+        logger.error(f"Error creating knowledge engine: {e}")
+        raise HTTPException(status_code=503, detail=KNOWLEDGE_BASE_UNAVAILABLE)
+
+    with _engine_lock:
+        if _engine is None:
+            _engine = engine
+        return _engine
+
+def answer_question(question: str, max_results: int) -> dict:
+    """Answer a question, failing with 503 if the knowledge base errors."""
+    if not question.strip():
+        raise HTTPException(status_code=400, detail="Question must not be empty")
+
+    engine = get_engine()
+    try:
+        response = engine.answer(question, max_results)
+    except Exception as e:
+        logger.error(f"Error answering question: {e}")
+        raise HTTPException(status_code=503, detail=KNOWLEDGE_BASE_UNAVAILABLE)
+
+    return format_response(question, response)
+
+def format_response(question: str, response: QueryResponse) -> dict:
+    """Convert a QueryResponse into the API's answer-with-citations shape."""
     return {
-        "query": q,
-        "answer": "This is a placeholder response. Query processing coming soon!",
-        "citations": [],
-        "confidence": 0.0
+        "query": question,
+        "answer": response.answer,
+        "citations": [
+            {
+                "source": result.source,
+                "file_path": result.metadata.get("file_path"),
+                "score": result.score,
+                "excerpt": result.content[:300]
+            }
+            for result in response.sources
+        ],
+        "confidence": response.confidence
     }
+
+@app.get("/api/query", dependencies=[Depends(require_api_key)])
+def query(q: str = Query(..., min_length=1), max_results: int = Query(5, ge=1, le=20)):
+    """Answer a question from the indexed documents."""
+    return answer_question(q, max_results)
+
+@app.post("/api/ask", dependencies=[Depends(require_api_key)])
+def ask(request: AskRequest):
+    """Answer a question from the indexed documents, with citations."""
+    return answer_question(request.question, request.max_results)
@@ -0,0 +1,91 @@
+"""
+API Middleware - Request correlation, access logging and metrics.
+"""
+
+import logging
+import re
+import time
+import uuid
+
+from prometheus_client import Counter, Histogram
+from starlette.middleware.base import BaseHTTPMiddleware
+from starlette.requests import Request
+
+from utils.logging import request_id_var
+
+logger = logging.getLogger("stackguide.access")
+
+REQUEST_ID_HEADER = "X-Request-ID"
+
+# Client-supplied request IDs are only trusted if they match this pattern
+REQUEST_ID_PATTERN = re.compile(r"[A-Za-z0-9._-]{1,128}")
+
+# Route label for requests that matched no route, to keep label cardinality bounded
+UNMATCHED_ROUTE = "unmatched"
+
+HTTP_REQUESTS = Counter(
+    "stackguide_http_requests_total",
+    "HTTP requests handled by the API",
+    ["method", "route", "status"],
+)
+HTTP_REQUEST_DURATION = Histogram(
+    "stackguide_http_request_duration_seconds",
+    "HTTP request latency in seconds",
+    ["method", "route"],
+)
+
+
+class RequestLoggingMiddleware(BaseHTTPMiddleware):
+    """Assign each request a correlation ID and emit one access log entry."""
+
+    async def dispatch(self, request: Request, call_next):
+        request_id = request.headers.get(REQUEST_ID_HEADER, "")
+        if not REQUEST_ID_PATTERN.fullmatch(request_id):
+            request_id = uuid.uuid4().hex
+        token = request_id_var.set(request_id)
+        start = time.perf_counter()
+        status = 500
+        size = None
+
+        try:
+            response = await call_next(request)
+            status = response.status_code
+            size = response.headers.get("content-length")
+            response.headers[REQUEST_ID_HEADER] = request_id
+            return response
+        finally:
+            latency_ms = round((time.perf_counter() - start) * 1000, 2)
+            client_ip = request.client.host if request.client else None
+            logger.info(
+                f"{request.method} {request.url.path} {status} {latency_ms}ms {client_ip}",
+                extra={
+                    "method": request.method,
+                    "path": request.url.path,
+                    "status": status,
+                    "size": int(size) if size else None,
+                    "latency_ms": latency_ms,
+                    "client_ip": client_ip,
+                },
+            )
+            request_id_var.reset(token)
+
+
+class MetricsMiddleware(BaseHTTPMiddleware):
+    """Record request counts and latency per route template for Prometheus."""
+
+    async def dispatch(self, request: Request, call_next):
+        start = time.perf_counter()
+        status = 500
+
+        try:
+            response = await call_next(request)
+            status = response.status_code
+            return response
+        finally:
+            # The router stores the matched route in the scope; use its template, not the raw path
+            route = request.scope.get("route")
+            route_label = getattr(route, "path", UNMATCHED_ROUTE)
+            HTTP_REQUESTS.labels(request.method, route_label, str(status)).inc()
+            HTTP_REQUEST_DURATION.labels(request.method, route_label).observe(
+                time.perf_counter() - start
+            )
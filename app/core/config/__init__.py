@@ -6,12 +6,16 @@ This package provides comprehensive configuration management capabilities:
 - Source management with validation and CRUD operations
 - Configuration persistence and file management
 - Unified configuration manager interface
+- Startup validation of runtime settings
+- CORS policy loaded from the environment
 """
 
-from .models import SourceConfig, Settings, AutoDiscoveryConfig, IngestionConfig, StorageConfig
+from .models import SourceConfig, Settings, AutoDiscoveryConfig, IngestionConfig, StorageConfig, CORSConfig
 from .manager import ConfigManager
 from .sources import SourceManager
 from .persistence import ConfigPersistence
+from .cors import load_cors_config
+from .validation import ConfigValidationError, DEFAULT_DATA_DIR, validate_startup
 
 __all__ = [
     'SourceConfig',
@@ -19,7 +23,12 @@ __all__ = [
     'AutoDiscoveryConfig',
     'IngestionConfig',
     'StorageConfig',
+    'CORSConfig',
     'ConfigManager',
     'SourceManager',
-    'ConfigPersistence'
+    'ConfigPersistence',
+    'load_cors_config',
+    'ConfigValidationError',
+    'DEFAULT_DATA_DIR',
+    'validate_startup'
 ]
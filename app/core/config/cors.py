@@ -0,0 +1,86 @@
+"""
+CORS Configuration - Loads the API's cross-origin policy from the environment.
+
+Origins are given as a comma-separated list. Entries may use a leading
+wildcard label (e.g. https://*.example.com) to allow any subdomain.
+"""
+
+import os
+import re
+from typing import Any, Dict, List, Optional
+
+from .models import CORSConfig
+
+
+def _split(value: Optional[str]) -> List[str]:
+    """Split a comma-separated environment value into trimmed entries."""
+    if not value:
+        return []
+    return [item.strip() for item in value.split(",") if item.strip()]
+
+
+def load_cors_config(env: Dict[str, str] = None) -> CORSConfig:
+    """
+    Build the CORS policy from CORS_* environment variables.
+
+    Args:
+        env: Environment mapping (defaults to os.environ)
+
+    Returns:
+        Parsed CORS configuration
+    """
+    env = os.environ if env is None else env
+    defaults = CORSConfig()
+    max_age = env.get("CORS_MAX_AGE", "")
+
+    return CORSConfig(
+        allow_origins=_split(env.get("CORS_ALLOW_ORIGINS")),
+        allow_methods=_split(env.get("CORS_ALLOW_METHODS")) or defaults.allow_methods,
+        allow_headers=_split(env.get("CORS_ALLOW_HEADERS")) or defaults.allow_headers,
+        expose_headers=_split(env.get("CORS_EXPOSE_HEADERS")) or defaults.expose_headers,
+        allow_credentials=env.get("CORS_ALLOW_CREDENTIALS", "false").lower() in ("1", "true", "yes"),
+        max_age=int(max_age) if max_age.isdigit() else defaults.max_age,
+    )
+
+
+def origin_regex(origins: List[str]) -> Optional[str]:
+    """
+    Build a regex matching all wildcard subdomain origins.
+
+    Args:
+        origins: Configured origins, possibly containing '*.' labels
+
+    Returns:
+        Regex string, or None if no wildcard origins are configured
+    """
+    patterns = []
+    for origin in origins:
+        if "://*." not in origin:
+            continue
+        scheme, _, host = origin.partition("://*.")
+        patterns.append(re.escape(scheme) + r"://[A-Za-z0-9-]+(\.[A-Za-z0-9-]+)*\." + re.escape(host))
+
+    if not patterns:
+        return None
+    return "^(" + "|".join(patterns) + ")$"
+
+
+def middleware_options(config: CORSConfig) -> Dict[str, Any]:
+    """
+    Translate a CORS configuration into CORSMiddleware keyword arguments.
+
+    Args:
+        config: CORS configuration
+
+    Returns:
+        Keyword arguments for fastapi.middleware.cors.CORSMiddleware
+    """
+    return {
+        "allow_origins": [origin for origin in config.allow_origins if "*." not in origin],
+        "allow_origin_regex": origin_regex(config.allow_origins),
+        "allow_methods": config.allow_methods,
+        "allow_headers": config.allow_headers,
+        "expose_headers": config.expose_headers,
+        "allow_credentials": config.allow_credentials,
+        "max_age": config.max_age,
+    }
@@ -67,3 +67,14 @@ class StorageConfig:
     backup_enabled: bool = True
     backup_interval_hours: int = 24
     max_backup_files: int = 10
+
+
+@dataclass
+class CORSConfig:
+    """Configuration for the API's cross-origin resource sharing policy."""
+    allow_origins: List[str] = field(default_factory=list)
+    allow_methods: List[str] = field(default_factory=lambda: ["GET", "POST", "OPTIONS"])
+    allow_headers: List[str] = field(default_factory=lambda: ["Content-Type", "Authorization", "X-API-Key", "X-Request-ID"])
+    expose_headers: List[str] = field(default_factory=lambda: ["X-Request-ID"])
+    allow_credentials: bool = False
+    max_age: int = 600
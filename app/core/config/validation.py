@@ -0,0 +1,260 @@
+"""
+Startup Validation - Checks the runtime configuration before serving requests.
+
+This module validates environment settings and the loaded configuration at
+boot, collecting every problem into a single report so the service fails
+fast instead of breaking midway through the first request.
+"""
+
+import json
+import logging
+import os
+from pathlib import Path
+from typing import Any, Dict, List, Optional
+
+from .cors import load_cors_config
+from .models import Settings
+from .persistence import ConfigPersistence
+
+logger = logging.getLogger(__name__)
+
+VALID_LOG_LEVELS = ["DEBUG", "INFO", "WARNING", "ERROR", "CRITICAL"]
+VALID_LOG_FORMATS = ["text", "json"]
+
+# Shortest API key accepted in API_KEYS
+MIN_API_KEY_LENGTH = 16
+
+# Data directory used when DATA_DIR is unset (mounted at /data in the containers)
+DEFAULT_DATA_DIR = "/data"
+
+INTEGER_SETTINGS = [
+    "default_chunk_size",
+    "default_chunk_overlap",
+    "max_file_size_mb",
+    "scan_interval_minutes",
+]
+
+
+class ConfigValidationError(Exception):
+    """Raised when the runtime configuration has one or more problems."""
+
+    def __init__(self, problems: List[str]):
+        self.problems = problems
+        lines = "\n".join(f"  - {problem}" for problem in problems)
+        super().__init__(f"Invalid configuration ({len(problems)} problem(s)):\n{lines}")
+
+
+def _check_port(name: str, value: Optional[str], problems: List[str]):
+    """Check that an environment variable holds a valid TCP port."""
+    if value is None:
+        return
+    try:
+        port = int(value)
+    except ValueError:
+        problems.append(f"{name} must be an integer, got '{value}'")
+        return
+    if not 1 <= port <= 65535:
+        problems.append(f"{name} must be between 1 and 65535, got {port}")
+
+
+def _check_writable_dir(name: str, value: Optional[str], problems: List[str]):
+    """Check that an environment variable points to a writable directory."""
+    if not value:
+        return
+    path = Path(value)
+    if not path.exists():
+        problems.append(f"{name} directory '{value}' does not exist")
+    elif not path.is_dir():
+        problems.append(f"{name} '{value}' is not a directory")
+    elif not os.access(path, os.W_OK):
+        problems.append(f"{name} directory '{value}' is not writable")
+
+
+def validate_environment(env: Dict[str, str] = None) -> List[str]:
+    """
+    Validate service settings taken from the environment.
+
+    Args:
+        env: Environment mapping (defaults to os.environ)
+
+    Returns:
+        List of human-readable problems (empty if valid)
+    """
+    env = os.environ if env is None else env
+    problems: List[str] = []
+
+    for host_key, port_key in [("CHROMA_HOST", "CHROMA_PORT"), ("LLM_HOST", "LLM_PORT")]:
+        _check_port(port_key, env.get(port_key), problems)
+        # An unset host falls back to the service name; only an explicit empty value is wrong
+        if host_key in env and not env[host_key].strip():
+            problems.append(f"{host_key} is set but empty")
+
+    log_level = env.get("LOG_LEVEL")
+    if log_level is not None and log_level.upper() not in VALID_LOG_LEVELS:
+        problems.append(
+            f"LOG_LEVEL must be one of {', '.join(VALID_LOG_LEVELS)}, got '{log_level}'"
+        )
+
+    log_format = env.get("LOG_FORMAT")
+    if log_format is not None and log_format.lower() not in VALID_LOG_FORMATS:
+        problems.append(
+            f"LOG_FORMAT must be one of {', '.join(VALID_LOG_FORMATS)}, got '{log_format}'"
+        )
+
+    _check_writable_dir("DATA_DIR", env.get("DATA_DIR", DEFAULT_DATA_DIR), problems)
+
+    min_free_mb = env.get("MIN_FREE_DISK_MB")
+    if min_free_mb is not None and not min_free_mb.isdigit():
+        problems.append(f"MIN_FREE_DISK_MB must be a non-negative integer, got '{min_free_mb}'")
+
+    api_keys = [key.strip() for key in env.get("API_KEYS", "").split(",") if key.strip()]
+    if not api_keys:
+        logger.warning("API_KEYS is not set; /api routes will reject every request")
+    for index, key in enumerate(api_keys, 1):
+        if len(key) < MIN_API_KEY_LENGTH:
+            problems.append(
+                f"API_KEYS entry {index} is shorter than {MIN_API_KEY_LENGTH} characters"
+            )
+
+    cors = load_cors_config(env)
+    if cors.allow_credentials and "*" in cors.allow_origins:
+        problems.append("CORS_ALLOW_CREDENTIALS cannot be enabled when CORS_ALLOW_ORIGINS is '*'")
+    for origin in cors.allow_origins:
+        if origin == "*":
+            continue
+        scheme, separator, host = origin.partition("://")
+        if not separator:
+            problems.append(f"CORS origin '{origin}' must include a scheme (e.g. https://)")
+        elif not host or "/" in host:
+            problems.append(
+                f"CORS origin '{origin}' must be scheme://host[:port] with no path or trailing slash"
+            )
+        elif "*" in host and not (host.startswith("*.") and "*" not in host[2:]):
+            problems.append(
+                f"CORS origin '{origin}' may only use '*' as the first label (e.g. https://*.example.com)"
+            )
+        elif host.startswith("*.") and not host[2:].strip("."):
+            problems.append(f"CORS origin '{origin}' needs a domain after '*.'")
+    max_age = env.get("CORS_MAX_AGE")
+    if max_age is not None and not max_age.isdigit():
+        problems.append(f"CORS_MAX_AGE must be a non-negative integer, got '{max_age}'")
+
+    return problems
+
+
+def validate_settings(settings: Settings) -> List[str]:
+    """
+    Validate global ingestion settings for internal consistency.
+
+    Args:
+        settings: Parsed settings object
+
+    Returns:
+        List of human-readable problems (empty if valid)
+    """
+    problems: List[str] = []
+
+    if settings.default_chunk_size <= 0:
+        problems.append(f"default_chunk_size must be positive, got {settings.default_chunk_size}")
+    if settings.default_chunk_overlap < 0:
+        problems.append(f"default_chunk_overlap must not be negative, got {settings.default_chunk_overlap}")
+    elif settings.default_chunk_overlap >= settings.default_chunk_size > 0:
+        problems.append(
+            f"default_chunk_overlap ({settings.default_chunk_overlap}) must be smaller "
+            f"than default_chunk_size ({settings.default_chunk_size})"
+        )
+    if settings.max_file_size_mb <= 0:
+        problems.append(f"max_file_size_mb must be positive, got {settings.max_file_size_mb}")
+    if settings.scan_interval_minutes <= 0:
+        problems.append(f"scan_interval_minutes must be positive, got {settings.scan_interval_minutes}")
+
+    return problems
+
+
+def validate_config_file(config_path: Path = None) -> List[str]:
+    """
+    Strictly load and validate the configuration file.
+
+    Unlike ConfigManager, which falls back to defaults on any error, this
+    reports unreadable JSON and wrongly typed values as problems.
+
+    Args:
+        config_path: Path to configuration file (defaults to config/sources.json)
+
+    Returns:
+        List of human-readable problems (empty if valid)
+    """
+    persistence = ConfigPersistence(config_path)
+    path = persistence.config_path
+
+    if not path.exists():
+        logger.warning(f"Configuration file not found: {path}, defaults will be used")
+        return []
+
+    try:
+        with open(path, 'r') as f:
+            config: Any = json.load(f)
+    except json.JSONDecodeError as e:
+        return [f"{path} is not valid JSON: {e}"]
+    except OSError as e:
+        return [f"{path} could not be read: {e}"]
+
+    if not isinstance(config, dict):
+        return [f"{path} must contain a JSON object, got {type(config).__name__}"]
+
+    problems: List[str] = []
+
+    sources = config.get("sources", {})
+    if not isinstance(sources, dict):
+        problems.append(f"'sources' must be an object, got {type(sources).__name__}")
+    else:
+        for source_type, source_list in sources.items():
+            if not isinstance(source_list, list):
+                problems.append(
+                    f"'sources.{source_type}' must be a list, got {type(source_list).__name__}"
+                )
+
+    settings = config.get("settings", {})
+    if not isinstance(settings, dict):
+        problems.append(f"'settings' must be an object, got {type(settings).__name__}")
+        return problems
+
+    type_problems = []
+    for key in INTEGER_SETTINGS:
+        value = settings.get(key)
+        if value is not None and (isinstance(value, bool) or not isinstance(value, int)):
+            type_problems.append(
+                f"'settings.{key}' must be an integer, got {type(value).__name__} {value!r}"
+            )
+    auto_discovery = settings.get("auto_discovery")
+    if auto_discovery is not None and not isinstance(auto_discovery, dict):
+        type_problems.append(
+            f"'settings.auto_discovery' must be an object, got {type(auto_discovery).__name__}"
+        )
+    problems.extend(type_problems)
+
+    # Range checks only make sense once every value has the right type
+    if not type_problems:
+        problems.extend(validate_settings(persistence.parse_settings(config)))
+
+    return problems
+
+
+def validate_startup(config_path: Path = None, env: Dict[str, str] = None):
+    """
+    Validate the full runtime configuration and fail fast on any problem.
+
+    Args:
+        config_path: Path to configuration file (defaults to config/sources.json)
+        env: Environment mapping (defaults to os.environ)
+
+    Raises:
+        ConfigValidationError: If any problem was found
+    """
+    problems = validate_environment(env)
+    problems.extend(validate_config_file(config_path))
+
+    if problems:
+        raise ConfigValidationError(problems)
+
+    logger.info("Startup configuration validation passed")
@@ -34,9 +34,9 @@ class KnowledgeEngine:
         
         logger.info("Knowledge engine initialized")
     
-    def query(self, question: str, max_results: int = 5) -> QueryResponse:
+    def answer(self, question: str, max_results: int = 5) -> QueryResponse:
         """
-        Process a user query and return an answer with sources.
+        Answer a user query, letting retrieval and generation errors propagate.
         
         Args:
             question: User's question
@@ -44,37 +44,52 @@ class KnowledgeEngine:
             
         Returns:
             QueryResponse with answer and sources
-        """
-        try:
-            # Step 1: Create search query
-            search_query = SearchQuery(
-                text=question,
-                max_results=max_results
-            )
-            
-            # Step 2: Retrieve relevant documents
-            search_results = self.retriever.retrieve_documents(search_query)
-            
-            if not search_results:
-                return QueryResponse(
-                    answer="I couldn't find any relevant information to answer your question. Try rephrasing or adding more data sources.",
-                    sources=[],
-                    confidence=0.0
-                )
-            
-            # Step 3: Generate answer using retrieved documents
-            answer = self.generator.generate_answer(question, search_results)
             
-            # Step 4: Calculate confidence score
-            confidence = self.scorer.calculate_confidence(search_results, question)
-            
-            # Step 5: Return response with sources
+        Raises:
+            Exception: If retrieval or answer generation fails
+        """
+        # Step 1: Create search query
+        search_query = SearchQuery(
+            text=question,
+            max_results=max_results
+        )
+        
+        # Step 2: Retrieve relevant documents
+        search_results = self.retriever.search_documents(search_query)
+        
+        if not search_results:
             return QueryResponse(
-                answer=answer,
-                sources=search_results,
-                confidence=confidence
+                answer="I couldn't find any relevant information to answer your question. Try rephrasing or adding more data sources.",
+                sources=[],
+                confidence=0.0
             )
+        
+        # Step 3: Generate answer using retrieved documents
+        answer = self.generator.generate_answer(question, search_results)
+        
+        # Step 4: Calculate confidence score
+        confidence = self.scorer.calculate_confidence(search_results, question)
+        
+        # Step 5: Return response with sources
+        return QueryResponse(
+            answer=answer,
+            sources=search_results,
+            confidence=confidence
+        )
+    
+    def query(self, question: str, max_results: int = 5) -> QueryResponse:
+        """
+        Process a user query and return an answer with sources.
+        
+        Args:
+            question: User's question
+            max_results: Maximum number of source documents to retrieve
             
+        Returns:
+            QueryResponse with answer and sources
+        """
+        try:
+            return self.answer(question, max_results)
         except Exception as e:
             logger.error(f"Error processing query: {e}")
             return QueryResponse(
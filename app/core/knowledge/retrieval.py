@@ -40,54 +40,68 @@ class DocumentRetriever:
             self.collection = self.chroma_client.create_collection("stackguide_docs")
             logger.info("Created new Chroma collection")
     
-    def retrieve_documents(self, query: SearchQuery) -> List[SearchResult]:
+    def search_documents(self, query: SearchQuery) -> List[SearchResult]:
         """
-        Retrieve relevant documents using vector similarity search.
+        Search for relevant documents, letting Chroma errors propagate.
         
         Args:
             query: Search query with parameters
-            
+        
         Returns:
             List of relevant search results
+        
+        Raises:
+            Exception: If the Chroma query fails
         """
-        try:
-            # Use the question as the query vector
-            results = self.collection.query(
-                query_texts=[query.text],
-                n_results=query.max_results,
-                include=["documents", "metadatas", "distances"]
-            )
-            
-            search_results = []
+        # Use the question as the query vector
+        results = self.collection.query(
+            query_texts=[query.text],
+            n_results=query.max_results,
+            include=["documents", "metadatas", "distances"]
+        )
+        
+        search_results = []
+        
+        if results["documents"] and results["documents"][0]:
+            documents = results["documents"][0]
+            metadatas = results["metadatas"][0]
+            distances = results["distances"][0]
             
-            if results["documents"] and results["documents"][0]:
-                documents = results["documents"][0]
-                metadatas = results["metadatas"][0]
-                distances = results["distances"][0]
+            for i, (doc, metadata, distance) in enumerate(zip(documents, metadatas, distances)):
+                # Convert distance to similarity score (0-1, higher is better)
+                score = 1.0 - (distance / max(distances)) if distances else 0.5
                 
-                for i, (doc, metadata, distance) in enumerate(zip(documents, metadatas, distances)):
-                    # Convert distance to similarity score (0-1, higher is better)
-                    score = 1.0 - (distance / max(distances)) if distances else 0.5
-                    
-                    # Apply minimum score filter
-                    if score < query.min_score:
-                        continue
-                    
-                    # Create search result
-                    search_result = SearchResult(
-                        content=doc,
-                        metadata=metadata or {},
-                        score=score,
-                        source=metadata.get('source_file', f'result_{i}') if metadata else f'result_{i}'
-                    )
-                    
-                    search_results.append(search_result)
-                    
-                    logger.debug(f"Retrieved document {i+1}: score={score:.3f}, source={search_result.source}")
-            
-            logger.info(f"Retrieved {len(search_results)} documents for query: '{query.text[:50]}...'")
-            return search_results
+                # Apply minimum score filter
+                if score < query.min_score:
+                    continue
+                
+                # Create search result
+                search_result = SearchResult(
+                    content=doc,
+                    metadata=metadata or {},
+                    score=score,
+                    source=metadata.get('source_file', f'result_{i}') if metadata else f'result_{i}'
+                )
+                
+                search_results.append(search_result)
+                
+                logger.debug(f"Retrieved document {i+1}: score={score:.3f}, source={search_result.source}")
+        
+        logger.info(f"Retrieved {len(search_results)} documents for query: '{query.text[:50]}...'")
+        return search_results
+    
+    def retrieve_documents(self, query: SearchQuery) -> List[SearchResult]:
+        """
+        Retrieve relevant documents using vector similarity search.
+        
+        Args:
+            query: Search query with parameters
             
+        Returns:
+            List of relevant search results (empty on error)
+        """
+        try:
+            return self.search_documents(query)
         except Exception as e:
             logger.error(f"Error retrieving documents: {e}")
             return []
@@ -2,10 +2,69 @@
 Logging utilities for StackGuide
 """
 
+import json
 import logging
 import sys
+from contextvars import ContextVar
+from datetime import datetime, timezone
 from typing import Optional
 
+# Server loggers that install their own plain-text handlers
+SERVER_LOGGERS = ["uvicorn", "uvicorn.error", "uvicorn.access"]
+
+# Correlation ID of the request currently being handled, if any
+request_id_var: ContextVar[Optional[str]] = ContextVar("request_id", default=None)
+
+# Placeholder request ID for records logged outside a request
+NO_REQUEST_ID = "-"
+
+TEXT_FORMAT = '%(asctime)s - %(name)s - %(levelname)s - [%(request_id)s] %(message)s'
+
+# Attributes present on every LogRecord; anything else was passed via `extra`
+_RESERVED_ATTRS = set(vars(logging.LogRecord("", 0, "", 0, "", None, None))) | {"message", "asctime"}
+
+
+class RequestIdFilter(logging.Filter):
+    """Attach the current request ID to every log record."""
+
+    def filter(self, record: logging.LogRecord) -> bool:
+        record.request_id = request_id_var.get() or NO_REQUEST_ID
+        return True
+
+
+def _parse_level(level: str) -> int:
+    """Return the numeric level for a level name, falling back to INFO if unknown."""
+    value = logging.getLevelName(level.upper())
+    return value if isinstance(value, int) else logging.INFO
+
+
+class JsonFormatter(logging.Formatter):
+    """Format log records as single-line JSON objects."""
+
+    def format(self, record: logging.LogRecord) -> str:
+        entry = {
+            "time": datetime.fromtimestamp(record.created, tz=timezone.utc).isoformat(),
+            "level": record.levelname,
+            "logger": record.name,
+            "message": record.getMessage(),
+        }
+        for key, value in vars(record).items():
+            if key in _RESERVED_ATTRS or value is None:
+                continue
+            if key == "request_id" and value == NO_REQUEST_ID:
+                continue
+            entry[key] = value
+        if record.exc_info:
+            entry["exc_info"] = self.formatException(record.exc_info)
+        return json.dumps(entry, default=str)
+
+
+def _make_formatter(log_format: str) -> logging.Formatter:
+    """Return the formatter for the given LOG_FORMAT value ('text' or 'json')."""
+    if log_format.lower() == "json":
+        return JsonFormatter()
+    return logging.Formatter(TEXT_FORMAT)
+
 
 def get_logger(name: str, level: str = "INFO") -> logging.Logger:
     """
@@ -23,37 +82,43 @@ def get_logger(name: str, level: str = "INFO") -> logging.Logger:
     # Avoid adding handlers multiple times
     if not logger.handlers:
         handler = logging.StreamHandler(sys.stdout)
-        formatter = logging.Formatter(
-            '%(asctime)s - %(name)s - %(levelname)s - %(message)s'
-        )
-        handler.setFormatter(formatter)
+        handler.setFormatter(logging.Formatter(TEXT_FORMAT))
+        handler.addFilter(RequestIdFilter())
         logger.addHandler(handler)
     
-    logger.setLevel(getattr(logging, level.upper()))
+    logger.setLevel(_parse_level(level))
     return logger
 
 
-def setup_logging(level: str = "INFO", log_file: Optional[str] = None):
+def setup_logging(level: str = "INFO", log_file: Optional[str] = None, log_format: str = "text"):
     """
     Set up logging configuration for the entire application.
     
     Args:
-        level: Logging level
+        level: Logging level (unknown levels fall back to INFO)
         log_file: Optional log file path
+        log_format: Output format, 'text' or 'json'
     """
+    formatter = _make_formatter(log_format)
+    handlers = [logging.StreamHandler(sys.stdout)]
+    
+    # Add file handler if specified
+    if log_file:
+        handlers.append(logging.FileHandler(log_file))
+    
+    for handler in handlers:
+        handler.setFormatter(formatter)
+        handler.addFilter(RequestIdFilter())
+    
     # Configure root logger
     logging.basicConfig(
-        level=getattr(logging, level.upper()),
-        format='%(asctime)s - %(name)s - %(levelname)s - %(message)s',
-        handlers=[
-            logging.StreamHandler(sys.stdout)
-        ]
+        level=_parse_level(level),
+        handlers=handlers,
+        force=True
     )
     
-    # Add file handler if specified
-    if log_file:
-        file_handler = logging.FileHandler(log_file)
-        file_handler.setFormatter(
-            logging.Formatter('%(asctime)s - %(name)s - %(levelname)s - %(message)s')
-        )
-        logging.getLogger().addHandler(file_handler)
+    # Route uvicorn's output through the root handlers so every line shares one format
+    for name in SERVER_LOGGERS:
+        server_logger = logging.getLogger(name)
+        server_logger.handlers.clear()
+        server_logger.propagate = True